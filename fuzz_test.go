@@ -0,0 +1,39 @@
+package timezone
+
+import "testing"
+
+// FuzzParseOffset asserts three invariants over ParseOffset: every accepted
+// input round-trips through FormatOffset and re-parses to the same offset,
+// every accepted offset stays within the documented ±14:00 bound, and no
+// input - accepted or rejected - causes a panic.
+func FuzzParseOffset(f *testing.F) {
+	seeds := []string{
+		"Z", "UTC", "GMT", "+05", "-07:00", "+0530", "-0700",
+		"+053000", "-07:00:00", "UTC+05:30", "GMT-07:00",
+		"", "+", "-", "PST", "America/New_York", "+15:00", "-14:00",
+		"+1401", "-14:01", "+14:00:01", "+143000",
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		got, err := ParseOffset(s)
+		if err != nil {
+			return
+		}
+
+		if got < -14*3600 || got > 14*3600 {
+			t.Fatalf("ParseOffset(%q) = %d, outside the documented +/-14:00 bound", s, got)
+		}
+
+		canonical := FormatOffset(got, HHColonMMSS)
+		roundTripped, err := ParseOffset(canonical)
+		if err != nil {
+			t.Fatalf("ParseOffset(%q) = %d, but its canonical form %q failed to parse: %v", s, got, canonical, err)
+		}
+		if roundTripped != got {
+			t.Fatalf("ParseOffset(%q) = %d, round-trip through %q = %d", s, got, canonical, roundTripped)
+		}
+	})
+}
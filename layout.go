@@ -0,0 +1,72 @@
+package timezone
+
+import "fmt"
+
+// stdZoneToken identifies one of the zone-offset layout tokens recognized by
+// ParseOffsetLayout, mirroring the small set of "-0700"-style tokens Go's
+// time package uses internally (stdISO8601TZ, stdISO8601ColonTZ, etc.).
+type stdZoneToken int
+
+const (
+	stdHH stdZoneToken = iota
+	stdHHMM
+	stdHHColonMM
+	stdHHMMSS
+	stdHHColonMMSS
+	stdZHH
+	stdZHHMM
+	stdZHHColonMM
+	stdZHHMMSS
+	stdZHHColonMMSS
+)
+
+// zoneTokens maps every supported layout string to its stdZoneToken and the
+// exact value width (including sign) that token requires.
+var zoneTokens = map[string]struct {
+	tok   stdZoneToken
+	width int
+}{
+	"-07":       {stdHH, 3},
+	"-0700":     {stdHHMM, 5},
+	"-07:00":    {stdHHColonMM, 6},
+	"-070000":   {stdHHMMSS, 7},
+	"-07:00:00": {stdHHColonMMSS, 9},
+
+	"Z07":       {stdZHH, 3},
+	"Z0700":     {stdZHHMM, 5},
+	"Z07:00":    {stdZHHColonMM, 6},
+	"Z070000":   {stdZHHMMSS, 7},
+	"Z07:00:00": {stdZHHColonMMSS, 9},
+}
+
+// isZToken reports whether tok is one of the "Z"-prefixed layout tokens,
+// which accept the literal value "Z" as a shorthand for a zero offset.
+func isZToken(tok stdZoneToken) bool {
+	return tok >= stdZHH
+}
+
+// ParseOffsetLayout parses value as a time zone offset according to layout,
+// modeled on time.Parse but restricted to the zone-offset layout tokens Go's
+// time package recognizes: "-0700", "-07:00", "-07", "-070000", "-07:00:00",
+// and their "Z"-prefixed counterparts.
+//
+// Unlike the permissive ParseOffset, which accepts any supported width,
+// ParseOffsetLayout enforces exactly the width named by layout. This lets
+// callers that need strict RFC 3339 or RFC 822 compliance reject sloppy
+// input instead of silently accepting it.
+func ParseOffsetLayout(layout, value string) (int, error) {
+	zt, ok := zoneTokens[layout]
+	if !ok {
+		return 0, fmt.Errorf("timezone: unrecognized zone-offset layout %q", layout)
+	}
+
+	if isZToken(zt.tok) && value == "Z" {
+		return 0, nil
+	}
+
+	if len(value) != zt.width {
+		return 0, fmt.Errorf("timezone: zone offset %q does not match the width required by layout %q", value, layout)
+	}
+
+	return parseNumericalOffset(value)
+}
@@ -0,0 +1,44 @@
+package timezone
+
+import "testing"
+
+func TestParseOffsetLayout(t *testing.T) {
+	tests := []struct {
+		name    string
+		layout  string
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{name: "-07", layout: "-07", value: "-07", want: sec(-7, 0, 0)},
+		{name: "-0700", layout: "-0700", value: "-0700", want: sec(-7, 0, 0)},
+		{name: "-07:00", layout: "-07:00", value: "-07:00", want: sec(-7, 0, 0)},
+		{name: "-070000", layout: "-070000", value: "-070045", want: sec(-7, 0, -45)},
+		{name: "-07:00:00", layout: "-07:00:00", value: "+07:00:45", want: sec(7, 0, 45)},
+
+		{name: "Z07 literal", layout: "Z07", value: "Z", want: 0},
+		{name: "Z0700 literal", layout: "Z0700", value: "Z", want: 0},
+		{name: "Z07:00 offset", layout: "Z07:00", value: "+05:30", want: sec(5, 30, 0)},
+		{name: "Z070000 offset", layout: "Z070000", value: "-053000", want: sec(-5, -30, 0)},
+		{name: "Z07:00:00 offset", layout: "Z07:00:00", value: "+05:30:00", want: sec(5, 30, 0)},
+
+		{name: "unrecognized layout", layout: "-07:00:00:00", value: "+05:30", wantErr: true},
+		{name: "wrong width for -07", layout: "-07", value: "-0700", wantErr: true},
+		{name: "wrong width for -0700", layout: "-0700", value: "-07", wantErr: true},
+		{name: "sloppy width rejected by -07:00", layout: "-07:00", value: "-0700", wantErr: true},
+		{name: "Z literal rejected by non-Z layout", layout: "-07", value: "Z", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseOffsetLayout(tt.layout, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseOffsetLayout(%q, %q) error = %v, wantErr %v", tt.layout, tt.value, err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParseOffsetLayout(%q, %q) = %d, want %d", tt.layout, tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package timezone
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// locationCache memoizes the *time.Location values built by
+// ParseOffsetLocation, keyed by offset in seconds, so repeated calls for the
+// same offset return the same instance instead of allocating a new one.
+var locationCache sync.Map // map[int]*time.Location
+
+// ParseOffsetLocation parses s with ParseOffset and returns the corresponding
+// *time.Location, built via time.FixedZone. The location's name is a
+// canonical string derived from the offset, e.g. "UTC", "UTC+05:30", or
+// "UTC-07:00:45"; the seconds field appears only when it is nonzero.
+//
+// Locations are cached by offset, so calling ParseOffsetLocation repeatedly
+// with inputs that resolve to the same offset reuses a single *time.Location.
+func ParseOffsetLocation(s string) (*time.Location, error) {
+	sec, err := ParseOffset(s)
+	if err != nil {
+		return nil, err
+	}
+	return locationForOffset(sec), nil
+}
+
+// locationForOffset returns the cached *time.Location for sec, creating and
+// storing one via time.FixedZone if this is the first time sec is seen.
+func locationForOffset(sec int) *time.Location {
+	if loc, ok := locationCache.Load(sec); ok {
+		return loc.(*time.Location)
+	}
+	loc := time.FixedZone(offsetName(sec), sec)
+	actual, _ := locationCache.LoadOrStore(sec, loc)
+	return actual.(*time.Location)
+}
+
+// offsetName derives a canonical zone name such as "UTC", "UTC+05:30", or
+// "UTC-07:00:45" from an offset in seconds east of UTC.
+func offsetName(sec int) string {
+	if sec == 0 {
+		return "UTC"
+	}
+	sign, h, m, s := splitOffset(sec)
+	if s != 0 {
+		return fmt.Sprintf("UTC%c%02d:%02d:%02d", sign, h, m, s)
+	}
+	return fmt.Sprintf("UTC%c%02d:%02d", sign, h, m)
+}
+
+// splitOffset decomposes an offset in seconds east of UTC into a sign
+// ('+' or '-') and non-negative hour, minute, and second components.
+func splitOffset(sec int) (sign byte, h, m, s int) {
+	sign = '+'
+	if sec < 0 {
+		sign = '-'
+		sec = -sec
+	}
+	h = sec / 3600
+	m = (sec % 3600) / 60
+	s = sec % 60
+	return sign, h, m, s
+}
+
+// OffsetLayout identifies the textual width used by FormatOffset, mirroring
+// the "-0700"/"Z0700" family of zone-offset layout tokens used by Go's time
+// package.
+type OffsetLayout int
+
+// Supported OffsetLayout values. The Z-prefixed variants emit the literal
+// "Z" for a zero offset instead of a signed "+00:00"-style string.
+const (
+	HH OffsetLayout = iota
+	HHMM
+	HHColonMM
+	HHMMSS
+	HHColonMMSS
+	ZHH
+	ZHHMM
+	ZHHColonMM
+	ZHHMMSS
+	ZHHColonMMSS
+)
+
+// FormatOffset formats sec, an offset in seconds east of UTC, according to
+// layout. It is the inverse of ParseOffset for the numerical layouts, and of
+// the "Z"-prefixed layout tokens in Go's time package for the Z variants,
+// letting callers round-trip offsets without pulling in time.Parse.
+func FormatOffset(sec int, layout OffsetLayout) string {
+	switch layout {
+	case ZHH, ZHHMM, ZHHColonMM, ZHHMMSS, ZHHColonMMSS:
+		if sec == 0 {
+			return "Z"
+		}
+	}
+
+	sign, h, m, s := splitOffset(sec)
+
+	switch layout {
+	case HH, ZHH:
+		return fmt.Sprintf("%c%02d", sign, h)
+	case HHMM, ZHHMM:
+		return fmt.Sprintf("%c%02d%02d", sign, h, m)
+	case HHColonMM, ZHHColonMM:
+		return fmt.Sprintf("%c%02d:%02d", sign, h, m)
+	case HHMMSS, ZHHMMSS:
+		return fmt.Sprintf("%c%02d%02d%02d", sign, h, m, s)
+	case HHColonMMSS, ZHHColonMMSS:
+		return fmt.Sprintf("%c%02d:%02d:%02d", sign, h, m, s)
+	default:
+		return fmt.Sprintf("%c%02d:%02d", sign, h, m)
+	}
+}
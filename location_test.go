@@ -0,0 +1,77 @@
+package timezone
+
+import "testing"
+
+func TestFormatOffset(t *testing.T) {
+	tests := []struct {
+		name   string
+		sec    int
+		layout OffsetLayout
+		want   string
+	}{
+		{name: "HH", sec: sec(5, 0, 0), layout: HH, want: "+05"},
+		{name: "HH negative", sec: sec(-7, 0, 0), layout: HH, want: "-07"},
+		{name: "HHMM", sec: sec(5, 30, 0), layout: HHMM, want: "+0530"},
+		{name: "HHColonMM", sec: sec(5, 30, 0), layout: HHColonMM, want: "+05:30"},
+		{name: "HHMMSS", sec: sec(5, 30, 45), layout: HHMMSS, want: "+053045"},
+		{name: "HHColonMMSS", sec: sec(-5, -30, -45), layout: HHColonMMSS, want: "-05:30:45"},
+		{name: "ZHH zero", sec: 0, layout: ZHH, want: "Z"},
+		{name: "ZHHMM zero", sec: 0, layout: ZHHMM, want: "Z"},
+		{name: "ZHHColonMMSS zero", sec: 0, layout: ZHHColonMMSS, want: "Z"},
+		{name: "ZHHColonMM nonzero", sec: sec(5, 30, 0), layout: ZHHColonMM, want: "+05:30"},
+		{name: "HHColonMM zero", sec: 0, layout: HHColonMM, want: "+00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatOffset(tt.sec, tt.layout)
+			if got != tt.want {
+				t.Errorf("FormatOffset(%d, %v) = %q, want %q", tt.sec, tt.layout, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseOffsetLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantName string
+		wantErr  bool
+	}{
+		{name: "Z", input: "Z", wantName: "UTC"},
+		{name: "UTC+05:30", input: "UTC+05:30", wantName: "UTC+05:30"},
+		{name: "GMT-07:00", input: "GMT-07:00", wantName: "UTC-07:00"},
+		{name: "seconds precision", input: "+05:30:45", wantName: "UTC+05:30:45"},
+		{name: "invalid", input: "America/New_York", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := ParseOffsetLocation(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseOffsetLocation(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if loc.String() != tt.wantName {
+				t.Errorf("ParseOffsetLocation(%q) name = %q, want %q", tt.input, loc.String(), tt.wantName)
+			}
+		})
+	}
+}
+
+func TestParseOffsetLocationCache(t *testing.T) {
+	a, err := ParseOffsetLocation("+05:30")
+	if err != nil {
+		t.Fatalf("ParseOffsetLocation: %v", err)
+	}
+	b, err := ParseOffsetLocation("UTC+05:30")
+	if err != nil {
+		t.Fatalf("ParseOffsetLocation: %v", err)
+	}
+	if a != b {
+		t.Errorf("ParseOffsetLocation did not reuse cached *time.Location for the same offset")
+	}
+}
@@ -0,0 +1,101 @@
+package timezone
+
+import "fmt"
+
+// ParseOffsetOptions configures the opt-in behavior of ParseOffsetWith.
+type ParseOffsetOptions struct {
+	// AllowNamedZones enables resolution of the RFC 822 / military single-letter
+	// zone designators (A-Y, excluding J) and the common US abbreviations
+	// (EST, EDT, CST, CDT, MST, MDT, PST, PDT, UT). It is false by default, so
+	// ParseOffsetWith behaves exactly like ParseOffset unless explicitly opted in.
+	AllowNamedZones bool
+
+	// LocalOffset is the offset, in seconds east of UTC, used to resolve the
+	// military zone designator "J" ("local"). "J" is otherwise ambiguous, so
+	// ParseOffsetWith returns an error for it unless HasLocalOffset is true
+	// (LocalOffset itself may legitimately be 0, e.g. when local is UTC).
+	LocalOffset int
+
+	// HasLocalOffset reports whether LocalOffset was explicitly set, since
+	// LocalOffset's zero value is a valid offset and can't signal "unset" on
+	// its own.
+	HasLocalOffset bool
+
+	// Extra supplies additional zone names (checked before the built-in
+	// military and abbreviation tables) mapped to their offset in seconds
+	// east of UTC.
+	Extra map[string]int
+}
+
+// militaryZones maps the RFC 822 / military single-letter zone designators,
+// excluding "J" (which is ambiguous and handled separately), to their offset
+// in seconds east of UTC.
+var militaryZones = buildMilitaryZones()
+
+func buildMilitaryZones() map[byte]int {
+	m := make(map[byte]int, 24)
+	for c, offset := byte('A'), 1; c <= 'I'; c, offset = c+1, offset+1 {
+		m[c] = offset * 3600
+	}
+	for c, offset := byte('K'), 10; c <= 'M'; c, offset = c+1, offset+1 {
+		m[c] = offset * 3600
+	}
+	for c, offset := byte('N'), 1; c <= 'Y'; c, offset = c+1, offset+1 {
+		m[c] = -offset * 3600
+	}
+	return m
+}
+
+// namedZoneAbbrevs maps the common RFC 822 US zone abbreviations, plus "UT",
+// to their offset in seconds east of UTC.
+var namedZoneAbbrevs = map[string]int{
+	"UT":  0,
+	"EST": -5 * 3600,
+	"EDT": -4 * 3600,
+	"CST": -6 * 3600,
+	"CDT": -5 * 3600,
+	"MST": -7 * 3600,
+	"MDT": -6 * 3600,
+	"PST": -8 * 3600,
+	"PDT": -7 * 3600,
+}
+
+// ParseOffsetWith parses s like ParseOffset, additionally resolving named
+// zones when opts.AllowNamedZones is set. With AllowNamedZones false (the
+// zero value), ParseOffsetWith is identical to ParseOffset, so callers that do
+// not opt in see no change in results.
+//
+// When AllowNamedZones is true, s is first checked against opts.Extra, then
+// against the RFC 822 / military single-letter designators ("A".."Y",
+// excluding "J", which requires opts.HasLocalOffset) and the common US
+// abbreviations ("EST", "PDT", "UT", ...), before falling back to
+// ParseOffset.
+func ParseOffsetWith(s string, opts ParseOffsetOptions) (int, error) {
+	if !opts.AllowNamedZones {
+		return ParseOffset(s)
+	}
+
+	if off, ok := opts.Extra[s]; ok {
+		return off, nil
+	}
+
+	if off, ok := namedZoneAbbrevs[s]; ok {
+		return off, nil
+	}
+
+	if len(s) == 1 {
+		switch c := s[0]; {
+		case c == 'J':
+			if !opts.HasLocalOffset {
+				return 0, fmt.Errorf("timezone: zone designator %q is local and requires ParseOffsetOptions.HasLocalOffset", s)
+			}
+			return opts.LocalOffset, nil
+		case c >= 'A' && c <= 'Z':
+			if off, ok := militaryZones[c]; ok {
+				return off, nil
+			}
+		}
+	}
+
+	return ParseOffset(s)
+}
@@ -0,0 +1,50 @@
+package timezone
+
+import "testing"
+
+func TestParseOffsetWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		opts    ParseOffsetOptions
+		want    int
+		wantErr bool
+	}{
+		{name: "default behaves like ParseOffset", input: "PST", opts: ParseOffsetOptions{}, wantErr: true},
+		{name: "default still parses numeric", input: "+05:30", opts: ParseOffsetOptions{}, want: sec(5, 30, 0)},
+
+		{name: "military A", input: "A", opts: ParseOffsetOptions{AllowNamedZones: true}, want: sec(1, 0, 0)},
+		{name: "military M", input: "M", opts: ParseOffsetOptions{AllowNamedZones: true}, want: sec(12, 0, 0)},
+		{name: "military N", input: "N", opts: ParseOffsetOptions{AllowNamedZones: true}, want: sec(-1, 0, 0)},
+		{name: "military Y", input: "Y", opts: ParseOffsetOptions{AllowNamedZones: true}, want: sec(-12, 0, 0)},
+		{name: "military J without local", input: "J", opts: ParseOffsetOptions{AllowNamedZones: true}, wantErr: true},
+		{name: "military J with local", input: "J", opts: ParseOffsetOptions{AllowNamedZones: true, HasLocalOffset: true, LocalOffset: sec(-5, 0, 0)}, want: sec(-5, 0, 0)},
+		{name: "military J with UTC local", input: "J", opts: ParseOffsetOptions{AllowNamedZones: true, HasLocalOffset: true, LocalOffset: 0}, want: 0},
+
+		{name: "abbreviation PST", input: "PST", opts: ParseOffsetOptions{AllowNamedZones: true}, want: sec(-8, 0, 0)},
+		{name: "abbreviation EDT", input: "EDT", opts: ParseOffsetOptions{AllowNamedZones: true}, want: sec(-4, 0, 0)},
+		{name: "abbreviation UT", input: "UT", opts: ParseOffsetOptions{AllowNamedZones: true}, want: 0},
+
+		{
+			name:  "extra override",
+			input: "BST",
+			opts:  ParseOffsetOptions{AllowNamedZones: true, Extra: map[string]int{"BST": sec(1, 0, 0)}},
+			want:  sec(1, 0, 0),
+		},
+		{name: "still rejects unknown location", input: "America/New_York", opts: ParseOffsetOptions{AllowNamedZones: true}, wantErr: true},
+		{name: "still parses numeric with named zones enabled", input: "-07:00", opts: ParseOffsetOptions{AllowNamedZones: true}, want: sec(-7, 0, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseOffsetWith(tt.input, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseOffsetWith(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParseOffsetWith(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -13,9 +13,16 @@ import (
 // as names for the zero offset, and "UTC" or "GMT" prefixed
 // offsets (e.g., "UTC+5", "GMT-07:00").
 //
+// Seconds-precision offsets are also accepted, covering every width produced
+// by Go's time package zone layouts ("-0700", "-07:00", "-07", "-070000",
+// "-07:00:00" and their "Z"-prefixed counterparts): "+053000", "-07:00:00".
+// Minutes and seconds must be 0-59, and the offset must lie within ±14:00.
+//
 // This function does not parse time zone location names (e.g., "PST", "EST").
 // Parsing location names is ambiguous without a full time zone database context.
-// Use time.LoadLocation for location-based parsing.
+// Use time.LoadLocation for location-based parsing, or ParseOffsetWith with
+// ParseOffsetOptions.AllowNamedZones if you specifically need the RFC 822 /
+// military zone abbreviations and accept that ambiguity.
 //
 // If the string does not match a supported numerical offset or "Z", "UTC", "GMT",
 // it returns an error.
@@ -65,71 +72,85 @@ func parseNumericalOffset(s string) (int, error) {
 		return 0, fmt.Errorf("timezone: invalid time zone offset %q", sOrig)
 	}
 
-	var h, m int
+	var h, m, sc int
+	var ok bool
 
 	switch len(s) {
 	case 1: // ±H
-		if !isDigits(s) {
-			return 0, fmt.Errorf("timezone: invalid time zone offset %q", sOrig)
-		}
-		h = parseDigits(s)
-		m = 0
+		h, ok = digit1(s)
 
 	case 2: // ±HH
-		if !isDigits(s) {
-			return 0, fmt.Errorf("timezone: invalid time zone offset %q", sOrig)
-		}
-		h = parseDigits(s)
-		m = 0
+		h, ok = digits2(s)
 
 	case 3: // ±HMM
-		if !isDigits(s) {
-			return 0, fmt.Errorf("timezone: invalid time zone offset %q", sOrig)
+		h, ok = digit1(s[:1])
+		if ok {
+			m, ok = digits2(s[1:])
 		}
-		h = parseDigits(s[:1])
-		m = parseDigits(s[1:])
 
 	case 4: // ±HHMM
-		if !isDigits(s) {
-			return 0, fmt.Errorf("timezone: invalid time zone offset %q", sOrig)
+		h, ok = digits2(s[:2])
+		if ok {
+			m, ok = digits2(s[2:])
 		}
-		h = parseDigits(s[:2])
-		m = parseDigits(s[2:])
 
 	case 5: // ±HH:MM
-		if s[2] != ':' || !isDigits(s[:2]) || !isDigits(s[3:]) {
-			return 0, fmt.Errorf("timezone: invalid time zone offset %q", sOrig)
+		h, ok = digits2(s[:2])
+		if ok && s[2] == ':' {
+			m, ok = digits2(s[3:])
+		} else {
+			ok = false
+		}
+
+	case 6: // ±HHMMSS
+		h, ok = digits2(s[:2])
+		if ok {
+			m, ok = digits2(s[2:4])
+		}
+		if ok {
+			sc, ok = digits2(s[4:])
+		}
+
+	case 8: // ±HH:MM:SS
+		h, ok = digits2(s[:2])
+		if ok && s[2] == ':' {
+			m, ok = digits2(s[3:5])
+		} else {
+			ok = false
+		}
+		if ok && s[5] == ':' {
+			sc, ok = digits2(s[6:])
+		} else {
+			ok = false
 		}
-		h = parseDigits(s[:2])
-		m = parseDigits(s[3:])
 
 	default:
-		return 0, fmt.Errorf("timezone: invalid time zone offset %q", sOrig)
+		ok = false
 	}
 
-	if h > 14 || m > 59 {
+	total := h*3600 + m*60 + sc
+	if !ok || m > 59 || sc > 59 || total > 14*3600 {
 		return 0, fmt.Errorf("timezone: invalid time zone offset %q", sOrig)
 	}
 
-	return sign * (h*3600 + m*60), nil
+	return sign * total, nil
 }
 
-// isDigits checks if string s contains only ASCII digits.
-func isDigits(s string) bool {
-	for i := 0; i < len(s); i++ {
-		if s[i] < '0' || s[i] > '9' {
-			return false
-		}
+// digit1 validates and converts a single ASCII digit in one pass, reporting
+// ok=false for anything else (including the empty string).
+func digit1(s string) (v int, ok bool) {
+	if len(s) != 1 || s[0] < '0' || s[0] > '9' {
+		return 0, false
 	}
-	return true
+	return int(s[0] - '0'), true
 }
 
-// parseDigits converts ASCII digits s to integer.
-// Assumes s contains only digits.
-func parseDigits(s string) int {
-	result := 0
-	for i := 0; i < len(s); i++ {
-		result = result*10 + int(s[i]-'0')
+// digits2 validates and converts a two-byte ASCII digit pair in one pass,
+// avoiding the separate full-string isDigits/parseDigits walks the loose
+// parser used to perform for every field.
+func digits2(s string) (v int, ok bool) {
+	if len(s) != 2 || s[0] < '0' || s[0] > '9' || s[1] < '0' || s[1] > '9' {
+		return 0, false
 	}
-	return result
+	return int(s[0]-'0')*10 + int(s[1]-'0'), true
 }
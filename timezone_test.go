@@ -48,6 +48,16 @@ func TestParseOffset(t *testing.T) {
 		{name: "Min -1400", input: "-1400", want: sec(-14, 0, 0), wantErr: false},
 		{name: "Min -14", input: "-14", want: sec(-14, 0, 0), wantErr: false},
 
+		{name: "+HHMMSS", input: "+053000", want: sec(5, 30, 0), wantErr: false},
+		{name: "+HHMMSS with seconds", input: "+053045", want: sec(5, 30, 45), wantErr: false},
+		{name: "-HHMMSS", input: "-053045", want: sec(-5, -30, -45), wantErr: false},
+		{name: "+HH:MM:SS", input: "+05:30:00", want: sec(5, 30, 0), wantErr: false},
+		{name: "+HH:MM:SS with seconds", input: "+05:30:45", want: sec(5, 30, 45), wantErr: false},
+		{name: "-HH:MM:SS", input: "-05:30:45", want: sec(-5, -30, -45), wantErr: false},
+		{name: "Zero -00:00:00", input: "-00:00:00", want: 0, wantErr: false},
+		{name: "Max +14:00:00", input: "+14:00:00", want: sec(14, 0, 0), wantErr: false},
+		{name: "Min -14:00:00", input: "-14:00:00", want: sec(-14, 0, 0), wantErr: false},
+
 		// --- Invalid cases ---
 		{name: "Empty", input: "", wantErr: true},
 		{name: "Just +", input: "+", wantErr: true},
@@ -77,6 +87,15 @@ func TestParseOffset(t *testing.T) {
 		{name: "Minute > 59", input: "+05:60", wantErr: true},
 		{name: "Minute > 59 num", input: "+0560", wantErr: true},
 		{name: "Minute > 59 HMM", input: "+560", wantErr: true},
+		{name: "Second > 59", input: "+05:30:60", wantErr: true},
+		{name: "Second > 59 num", input: "+053060", wantErr: true},
+		{name: "Invalid format HH:MM:S", input: "+05:30:0", wantErr: true},
+		{name: "Invalid format ::: seconds", input: "+05:30::0", wantErr: true},
+
+		{name: "Over bound +14:01", input: "+1401", wantErr: true},
+		{name: "Over bound -14:01", input: "-14:01", wantErr: true},
+		{name: "Over bound +14:00:01", input: "+14:00:01", wantErr: true},
+		{name: "Over bound +14:30:00", input: "+143000", wantErr: true},
 	}
 
 	for _, tt := range tests {
@@ -105,6 +124,8 @@ func BenchmarkParseOffset(b *testing.B) {
 		{name: "GMT", input: "GMT"},
 		{name: "HH:MM", input: "+05:30"},
 		{name: "HHMM", input: "-0400"},
+		{name: "HHMMSS", input: "+053045"},
+		{name: "HH:MM:SS", input: "-05:30:45"},
 		{name: "UTC+H", input: "UTC+5"},
 		{name: "GMT-HH:MM", input: "GMT-07:00"},
 		{name: "Error", input: "PST"},